@@ -1,6 +1,13 @@
 package store
 
-import "google.golang.org/protobuf/encoding/protojson"
+import (
+	"errors"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ErrAlreadyExists is returned by drivers when a write violates a unique constraint.
+var ErrAlreadyExists = errors.New("already exists")
 
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
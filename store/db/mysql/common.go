@@ -1,6 +1,12 @@
 package mysql
 
-import "google.golang.org/protobuf/encoding/protojson"
+import (
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/usememos/memos/store"
+)
 
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
@@ -8,3 +14,15 @@ var (
 		DiscardUnknown: true,
 	}
 )
+
+// erDupEntry is the MySQL error number for duplicate key violations.
+const erDupEntry = 1062
+
+// convertUniqueViolation maps duplicate key violations to store.ErrAlreadyExists.
+func convertUniqueViolation(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == erDupEntry {
+		return errors.Wrap(store.ErrAlreadyExists, err.Error())
+	}
+	return err
+}
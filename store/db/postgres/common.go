@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/usememos/memos/store"
 )
 
 var (
@@ -13,6 +17,18 @@ var (
 	}
 )
 
+// uniqueViolation is the SQLSTATE code for unique constraint violations.
+const uniqueViolation = "23505"
+
+// convertUniqueViolation maps unique constraint violations to store.ErrAlreadyExists.
+func convertUniqueViolation(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return errors.Wrap(store.ErrAlreadyExists, err.Error())
+	}
+	return err
+}
+
 func placeholder(n int) string {
 	return "$" + fmt.Sprint(n)
 }
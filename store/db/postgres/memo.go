@@ -42,7 +42,7 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		&create.UpdatedTs,
 		&create.RowStatus,
 	); err != nil {
-		return nil, err
+		return nil, convertUniqueViolation(err)
 	}
 
 	return create, nil
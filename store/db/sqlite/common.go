@@ -1,9 +1,28 @@
 package sqlite
 
-import "google.golang.org/protobuf/encoding/protojson"
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/usememos/memos/store"
+)
 
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
 		DiscardUnknown: true,
 	}
 )
+
+// convertUniqueViolation maps unique and primary key violations to store.ErrAlreadyExists.
+func convertUniqueViolation(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			return errors.Wrap(store.ErrAlreadyExists, err.Error())
+		}
+	}
+	return err
+}